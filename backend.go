@@ -0,0 +1,119 @@
+package main
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"os"
+)
+
+// createdTimeLayout is the format every backend renders Container.Created
+// in, so formatTime (main.go) can parse it the same way regardless of which
+// backend produced it.
+const createdTimeLayout = "2006-01-02 15:04:05 -0700 MST"
+
+// Container is the runtime-neutral view of a container shown in the table.
+// Backends populate it from whatever native representation they work with
+// (the Docker SDK's container list entries, CRI Container messages, ...).
+type Container struct {
+	ID      string
+	Image   string
+	Command string
+	Created string
+	Status  string
+	Ports   string
+	Names   string
+	State   string
+	Labels  map[string]string
+}
+
+// Stats is a single sample of a container's resource usage, as produced by
+// Backend.Stats.
+type Stats struct {
+	ContainerID string
+	CPUPercent  float64
+	MemUsage    uint64
+	MemLimit    uint64
+	NetRx       uint64
+	NetTx       uint64
+	BlockRead   uint64
+	BlockWrite  uint64
+}
+
+// Backend abstracts the container runtime the TUI talks to, so the rest of
+// the program never shells out or parses runtime-specific JSON directly.
+// Implementations: dockerBackend (Docker Engine via the official SDK) and
+// criBackend (containerd/CRI-O via the CRI gRPC API).
+type Backend interface {
+	Name() string
+	List(ctx context.Context) ([]Container, error)
+	Start(ctx context.Context, id string) error
+	Stop(ctx context.Context, id string) error
+	Remove(ctx context.Context, id string) error
+	Logs(ctx context.Context, id string) (io.ReadCloser, error)
+	Stats(ctx context.Context, id string) (<-chan Stats, error)
+
+	// The remaining methods back the command palette. Not every runtime
+	// supports every operation (CRI has no first-class rename or exec, for
+	// instance) — implementations that can't support one return an error
+	// rather than silently no-op'ing.
+	Restart(ctx context.Context, id string) error
+	Pause(ctx context.Context, id string) error
+	Unpause(ctx context.Context, id string) error
+	Kill(ctx context.Context, id string, signal string) error
+	Rename(ctx context.Context, id string, newName string) error
+	Inspect(ctx context.Context, id string) (string, error)
+	Prune(ctx context.Context) (string, error)
+}
+
+// dockerSocketPaths are checked, in order, when auto-detecting a runtime.
+var dockerSocketPaths = []string{
+	"/var/run/docker.sock",
+}
+
+// criSocketPaths are checked, in order, when auto-detecting a runtime. These
+// match the default endpoints crictl itself probes.
+var criSocketPaths = []string{
+	"/run/containerd/containerd.sock",
+	"/run/crio/crio.sock",
+}
+
+func socketExists(path string) bool {
+	info, err := os.Stat(path)
+	return err == nil && !info.IsDir()
+}
+
+// NewBackend resolves the --runtime flag ("docker", "cri", or "" for
+// auto-detect) into a concrete Backend. Auto-detect prefers Docker, since
+// that's the common case, and falls back to the first reachable CRI socket.
+func NewBackend(runtime string) (Backend, error) {
+	switch runtime {
+	case "docker":
+		return newDockerBackend()
+	case "cri":
+		return newCRIBackend(firstReachableSocket(criSocketPaths, "/run/containerd/containerd.sock"))
+	case "":
+		for _, p := range dockerSocketPaths {
+			if socketExists(p) {
+				return newDockerBackend()
+			}
+		}
+		for _, p := range criSocketPaths {
+			if socketExists(p) {
+				return newCRIBackend(p)
+			}
+		}
+		return nil, fmt.Errorf("no docker or CRI socket found; pass --runtime explicitly")
+	default:
+		return nil, fmt.Errorf("unknown runtime %q (want \"docker\" or \"cri\")", runtime)
+	}
+}
+
+func firstReachableSocket(paths []string, fallback string) string {
+	for _, p := range paths {
+		if socketExists(p) {
+			return p
+		}
+	}
+	return fallback
+}
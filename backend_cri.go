@@ -0,0 +1,271 @@
+package main
+
+import (
+	"bufio"
+	"context"
+	"fmt"
+	"io"
+	"os"
+	"time"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials/insecure"
+	runtimeapi "k8s.io/cri-api/pkg/apis/runtime/v1"
+)
+
+// criBackend talks to a CRI-compatible runtime (containerd, CRI-O) over the
+// same unix-socket gRPC API crictl uses, so the TUI works without Docker
+// installed at all.
+type criBackend struct {
+	conn    *grpc.ClientConn
+	runtime runtimeapi.RuntimeServiceClient
+}
+
+func newCRIBackend(socketPath string) (Backend, error) {
+	conn, err := grpc.NewClient("unix://"+socketPath,
+		grpc.WithTransportCredentials(insecure.NewCredentials()))
+	if err != nil {
+		return nil, fmt.Errorf("dialing CRI socket %s: %w", socketPath, err)
+	}
+
+	return &criBackend{
+		conn:    conn,
+		runtime: runtimeapi.NewRuntimeServiceClient(conn),
+	}, nil
+}
+
+func (b *criBackend) Name() string { return "cri" }
+
+func (b *criBackend) List(ctx context.Context) ([]Container, error) {
+	resp, err := b.runtime.ListContainers(ctx, &runtimeapi.ListContainersRequest{})
+	if err != nil {
+		return nil, fmt.Errorf("listing CRI containers: %w", err)
+	}
+
+	containers := make([]Container, 0, len(resp.Containers))
+	for _, c := range resp.Containers {
+		containers = append(containers, Container{
+			ID:      c.Id,
+			Image:   c.Image.Image,
+			Command: c.Annotations["command"],
+			Created: time.Unix(0, c.CreatedAt).Format(createdTimeLayout),
+			Status:  c.State.String(),
+			Names:   c.Metadata.Name,
+			State:   criStateToDockerState(c.State),
+			Labels:  c.Labels,
+		})
+	}
+	return containers, nil
+}
+
+func (b *criBackend) Start(ctx context.Context, id string) error {
+	_, err := b.runtime.StartContainer(ctx, &runtimeapi.StartContainerRequest{ContainerId: id})
+	return err
+}
+
+func (b *criBackend) Stop(ctx context.Context, id string) error {
+	_, err := b.runtime.StopContainer(ctx, &runtimeapi.StopContainerRequest{ContainerId: id, Timeout: 10})
+	return err
+}
+
+func (b *criBackend) Remove(ctx context.Context, id string) error {
+	_, _ = b.runtime.StopContainer(ctx, &runtimeapi.StopContainerRequest{ContainerId: id, Timeout: 10})
+	_, err := b.runtime.RemoveContainer(ctx, &runtimeapi.RemoveContainerRequest{ContainerId: id})
+	return err
+}
+
+func (b *criBackend) Restart(ctx context.Context, id string) error {
+	if err := b.Stop(ctx, id); err != nil {
+		return err
+	}
+	return b.Start(ctx, id)
+}
+
+func (b *criBackend) Pause(ctx context.Context, id string) error {
+	return fmt.Errorf("pause is not supported by the CRI backend")
+}
+
+func (b *criBackend) Unpause(ctx context.Context, id string) error {
+	return fmt.Errorf("unpause is not supported by the CRI backend")
+}
+
+func (b *criBackend) Kill(ctx context.Context, id string, signal string) error {
+	// CRI only exposes graceful stop with a timeout, not arbitrary signals.
+	return b.Stop(ctx, id)
+}
+
+func (b *criBackend) Rename(ctx context.Context, id string, newName string) error {
+	return fmt.Errorf("rename is not supported by the CRI backend")
+}
+
+func (b *criBackend) Inspect(ctx context.Context, id string) (string, error) {
+	status, err := b.runtime.ContainerStatus(ctx, &runtimeapi.ContainerStatusRequest{ContainerId: id, Verbose: true})
+	if err != nil {
+		return "", fmt.Errorf("inspecting %s: %w", id[:12], err)
+	}
+	return status.String(), nil
+}
+
+func (b *criBackend) Prune(ctx context.Context) (string, error) {
+	return "", fmt.Errorf("prune is not supported by the CRI backend; remove pods via crictl/kubectl")
+}
+
+// criLogTailLines mirrors the Docker backend's `Tail: "200"` so opening the
+// logs view costs the same regardless of backend.
+const criLogTailLines = 200
+
+func (b *criBackend) Logs(ctx context.Context, id string) (io.ReadCloser, error) {
+	status, err := b.runtime.ContainerStatus(ctx, &runtimeapi.ContainerStatusRequest{ContainerId: id})
+	if err != nil {
+		return nil, fmt.Errorf("resolving log path for %s: %w", id[:12], err)
+	}
+
+	f, err := os.Open(status.Status.LogPath)
+	if err != nil {
+		return nil, fmt.Errorf("opening CRI log file: %w", err)
+	}
+
+	if err := seekToTail(f, criLogTailLines); err != nil {
+		f.Close()
+		return nil, fmt.Errorf("seeking to log tail: %w", err)
+	}
+
+	return &tailReadCloser{ctx: ctx, r: bufio.NewReader(f), f: f}, nil
+}
+
+// seekToTail positions f so reading from it starts at (at most) the last n
+// lines, the same tail-then-follow behavior `docker logs -f --tail=N` gives,
+// instead of streaming the entire on-disk backlog before following.
+func seekToTail(f *os.File, n int) error {
+	info, err := f.Stat()
+	if err != nil {
+		return err
+	}
+
+	const chunkSize = 64 * 1024
+	buf := make([]byte, chunkSize)
+	offset := info.Size()
+	newlines := 0
+
+	for offset > 0 {
+		readSize := int64(chunkSize)
+		if offset < readSize {
+			readSize = offset
+		}
+		offset -= readSize
+
+		if _, err := f.ReadAt(buf[:readSize], offset); err != nil && err != io.EOF {
+			return err
+		}
+
+		for i := int(readSize) - 1; i >= 0; i-- {
+			if buf[i] != '\n' {
+				continue
+			}
+			newlines++
+			if newlines > n {
+				_, err := f.Seek(offset+int64(i)+1, io.SeekStart)
+				return err
+			}
+		}
+	}
+
+	_, err = f.Seek(0, io.SeekStart)
+	return err
+}
+
+func (b *criBackend) Stats(ctx context.Context, id string) (<-chan Stats, error) {
+	out := make(chan Stats)
+	go func() {
+		defer close(out)
+		ticker := time.NewTicker(2 * time.Second)
+		defer ticker.Stop()
+
+		var prev *runtimeapi.ContainerStats
+		for {
+			select {
+			case <-ctx.Done():
+				return
+			case <-ticker.C:
+				resp, err := b.runtime.ContainerStats(ctx, &runtimeapi.ContainerStatsRequest{ContainerId: id})
+				if err != nil {
+					return
+				}
+				out <- criStatsToStats(id, prev, resp.Stats)
+				prev = resp.Stats
+			}
+		}
+	}()
+	return out, nil
+}
+
+// criCPUPercent diffs two cumulative CPU-nanosecond samples the same way
+// dockerCPUPercent diffs the Docker stats API's cumulative counters, since
+// CRI's ContainerStats reports usage as a running total rather than a rate.
+func criCPUPercent(prev, cur *runtimeapi.ContainerStats) float64 {
+	if prev == nil || cur == nil || prev.Cpu == nil || cur.Cpu == nil {
+		return 0
+	}
+	if prev.Cpu.UsageCoreNanoSeconds == nil || cur.Cpu.UsageCoreNanoSeconds == nil {
+		return 0
+	}
+
+	cpuDelta := float64(cur.Cpu.UsageCoreNanoSeconds.Value) - float64(prev.Cpu.UsageCoreNanoSeconds.Value)
+	timeDelta := float64(cur.Cpu.Timestamp) - float64(prev.Cpu.Timestamp)
+	if cpuDelta <= 0 || timeDelta <= 0 {
+		return 0
+	}
+	return cpuDelta / timeDelta * 100
+}
+
+func criStatsToStats(id string, prev, s *runtimeapi.ContainerStats) Stats {
+	if s == nil {
+		return Stats{ContainerID: id}
+	}
+	st := Stats{ContainerID: id, CPUPercent: criCPUPercent(prev, s)}
+	if s.Memory != nil && s.Memory.WorkingSetBytes != nil {
+		st.MemUsage = s.Memory.WorkingSetBytes.Value
+	}
+	return st
+}
+
+func criStateToDockerState(s runtimeapi.ContainerState) string {
+	switch s {
+	case runtimeapi.ContainerState_CONTAINER_RUNNING:
+		return "running"
+	case runtimeapi.ContainerState_CONTAINER_EXITED:
+		return "exited"
+	case runtimeapi.ContainerState_CONTAINER_CREATED:
+		return "created"
+	default:
+		return "unknown"
+	}
+}
+
+// tailReadCloser follows a CRI log file the way `docker logs -f` follows
+// the container's stdout, polling for new data rather than relying on a
+// dedicated streaming RPC (CRI exposes logs as plain files on disk). ctx is
+// the same context Logs was called with, so cancelling it (view exit) wakes
+// Read with a terminal io.EOF instead of polling forever.
+type tailReadCloser struct {
+	ctx context.Context
+	r   *bufio.Reader
+	f   *os.File
+}
+
+func (t *tailReadCloser) Read(p []byte) (int, error) {
+	n, err := t.r.Read(p)
+	if err == io.EOF {
+		select {
+		case <-t.ctx.Done():
+			return n, io.EOF
+		case <-time.After(250 * time.Millisecond):
+			return n, nil
+		}
+	}
+	return n, err
+}
+
+func (t *tailReadCloser) Close() error {
+	return t.f.Close()
+}
@@ -0,0 +1,52 @@
+package main
+
+import (
+	"testing"
+
+	runtimeapi "k8s.io/cri-api/pkg/apis/runtime/v1"
+)
+
+func TestCriStateToDockerState(t *testing.T) {
+	tests := []struct {
+		state runtimeapi.ContainerState
+		want  string
+	}{
+		{runtimeapi.ContainerState_CONTAINER_RUNNING, "running"},
+		{runtimeapi.ContainerState_CONTAINER_EXITED, "exited"},
+		{runtimeapi.ContainerState_CONTAINER_CREATED, "created"},
+		{runtimeapi.ContainerState_CONTAINER_UNKNOWN, "unknown"},
+	}
+
+	for _, tt := range tests {
+		if got := criStateToDockerState(tt.state); got != tt.want {
+			t.Errorf("criStateToDockerState(%v) = %q, want %q", tt.state, got, tt.want)
+		}
+	}
+}
+
+func TestCriCPUPercent(t *testing.T) {
+	prev := &runtimeapi.ContainerStats{
+		Cpu: &runtimeapi.CpuUsage{
+			Timestamp:            1_000_000_000,
+			UsageCoreNanoSeconds: &runtimeapi.UInt64Value{Value: 1_000_000_000},
+		},
+	}
+	cur := &runtimeapi.ContainerStats{
+		Cpu: &runtimeapi.CpuUsage{
+			Timestamp:            2_000_000_000,
+			UsageCoreNanoSeconds: &runtimeapi.UInt64Value{Value: 1_500_000_000},
+		},
+	}
+
+	got := criCPUPercent(prev, cur)
+	want := 50.0
+	if got != want {
+		t.Errorf("criCPUPercent() = %v, want %v", got, want)
+	}
+}
+
+func TestCriCPUPercentNilSamples(t *testing.T) {
+	if got := criCPUPercent(nil, &runtimeapi.ContainerStats{}); got != 0 {
+		t.Errorf("criCPUPercent(nil, ...) = %v, want 0", got)
+	}
+}
@@ -0,0 +1,213 @@
+package main
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"strings"
+	"time"
+
+	"github.com/docker/docker/api/types"
+	"github.com/docker/docker/api/types/container"
+	"github.com/docker/docker/api/types/filters"
+	"github.com/docker/docker/client"
+)
+
+// dockerBackend talks to the Docker Engine over the official SDK, replacing
+// the earlier exec.Command("docker", ...) + JSON-line parsing.
+type dockerBackend struct {
+	cli *client.Client
+}
+
+func newDockerBackend() (Backend, error) {
+	cli, err := client.NewClientWithOpts(client.FromEnv, client.WithAPIVersionNegotiation())
+	if err != nil {
+		return nil, fmt.Errorf("connecting to docker: %w", err)
+	}
+	return &dockerBackend{cli: cli}, nil
+}
+
+func (b *dockerBackend) Name() string { return "docker" }
+
+func (b *dockerBackend) List(ctx context.Context) ([]Container, error) {
+	list, err := b.cli.ContainerList(ctx, container.ListOptions{All: true})
+	if err != nil {
+		return nil, fmt.Errorf("listing containers: %w", err)
+	}
+
+	containers := make([]Container, 0, len(list))
+	for _, c := range list {
+		var name string
+		if len(c.Names) > 0 {
+			name = c.Names[0]
+		}
+
+		containers = append(containers, Container{
+			ID:      c.ID,
+			Image:   c.Image,
+			Command: c.Command,
+			Created: formatDockerCreated(c.Created),
+			Status:  c.Status,
+			Ports:   formatDockerPorts(c.Ports),
+			Names:   name,
+			State:   c.State,
+			Labels:  c.Labels,
+		})
+	}
+	return containers, nil
+}
+
+func (b *dockerBackend) Start(ctx context.Context, id string) error {
+	return b.cli.ContainerStart(ctx, id, container.StartOptions{})
+}
+
+func (b *dockerBackend) Stop(ctx context.Context, id string) error {
+	return b.cli.ContainerStop(ctx, id, container.StopOptions{})
+}
+
+func (b *dockerBackend) Remove(ctx context.Context, id string) error {
+	_ = b.cli.ContainerStop(ctx, id, container.StopOptions{})
+	return b.cli.ContainerRemove(ctx, id, container.RemoveOptions{Force: true})
+}
+
+func (b *dockerBackend) Restart(ctx context.Context, id string) error {
+	return b.cli.ContainerRestart(ctx, id, container.StopOptions{})
+}
+
+func (b *dockerBackend) Pause(ctx context.Context, id string) error {
+	return b.cli.ContainerPause(ctx, id)
+}
+
+func (b *dockerBackend) Unpause(ctx context.Context, id string) error {
+	return b.cli.ContainerUnpause(ctx, id)
+}
+
+func (b *dockerBackend) Kill(ctx context.Context, id string, signal string) error {
+	return b.cli.ContainerKill(ctx, id, signal)
+}
+
+func (b *dockerBackend) Rename(ctx context.Context, id string, newName string) error {
+	return b.cli.ContainerRename(ctx, id, newName)
+}
+
+func (b *dockerBackend) Inspect(ctx context.Context, id string) (string, error) {
+	raw, err := b.cli.ContainerInspect(ctx, id)
+	if err != nil {
+		return "", fmt.Errorf("inspecting %s: %w", id[:12], err)
+	}
+	out, err := json.MarshalIndent(raw, "", "  ")
+	if err != nil {
+		return "", fmt.Errorf("formatting inspect output: %w", err)
+	}
+	return string(out), nil
+}
+
+func (b *dockerBackend) Prune(ctx context.Context) (string, error) {
+	report, err := b.cli.ContainersPrune(ctx, filters.Args{})
+	if err != nil {
+		return "", fmt.Errorf("pruning containers: %w", err)
+	}
+	return fmt.Sprintf("removed %d containers, reclaimed %s", len(report.ContainersDeleted), formatBytes(report.SpaceReclaimed)), nil
+}
+
+func (b *dockerBackend) Logs(ctx context.Context, id string) (io.ReadCloser, error) {
+	return b.cli.ContainerLogs(ctx, id, container.LogsOptions{
+		ShowStdout: true,
+		ShowStderr: true,
+		Follow:     true,
+		Tail:       "200",
+	})
+}
+
+func (b *dockerBackend) Stats(ctx context.Context, id string) (<-chan Stats, error) {
+	resp, err := b.cli.ContainerStats(ctx, id, true)
+	if err != nil {
+		return nil, fmt.Errorf("streaming stats for %s: %w", id[:12], err)
+	}
+
+	out := make(chan Stats)
+	go decodeDockerStats(ctx, id, resp.Body, out)
+	return out, nil
+}
+
+// decodeDockerStats reads the newline-delimited JSON stream returned by the
+// stats API and converts each sample into our runtime-neutral Stats type
+// until the reader closes or ctx is cancelled.
+func decodeDockerStats(ctx context.Context, id string, body io.ReadCloser, out chan<- Stats) {
+	defer close(out)
+	defer body.Close()
+
+	dec := json.NewDecoder(body)
+	for {
+		if ctx.Err() != nil {
+			return
+		}
+
+		var raw types.StatsJSON
+		if err := dec.Decode(&raw); err != nil {
+			return
+		}
+
+		out <- Stats{
+			ContainerID: id,
+			CPUPercent:  dockerCPUPercent(raw),
+			MemUsage:    raw.MemoryStats.Usage,
+			MemLimit:    raw.MemoryStats.Limit,
+			NetRx:       sumNetworkField(raw.Networks, func(n types.NetworkStats) uint64 { return n.RxBytes }),
+			NetTx:       sumNetworkField(raw.Networks, func(n types.NetworkStats) uint64 { return n.TxBytes }),
+			BlockRead:   sumBlkioField(raw.BlkioStats.IoServiceBytesRecursive, "Read"),
+			BlockWrite:  sumBlkioField(raw.BlkioStats.IoServiceBytesRecursive, "Write"),
+		}
+	}
+}
+
+func dockerCPUPercent(s types.StatsJSON) float64 {
+	cpuDelta := float64(s.CPUStats.CPUUsage.TotalUsage) - float64(s.PreCPUStats.CPUUsage.TotalUsage)
+	sysDelta := float64(s.CPUStats.SystemUsage) - float64(s.PreCPUStats.SystemUsage)
+	if sysDelta <= 0 || cpuDelta <= 0 {
+		return 0
+	}
+	cpuCount := float64(len(s.CPUStats.CPUUsage.PercpuUsage))
+	if cpuCount == 0 {
+		cpuCount = 1
+	}
+	return (cpuDelta / sysDelta) * cpuCount * 100.0
+}
+
+func sumNetworkField(networks map[string]types.NetworkStats, field func(types.NetworkStats) uint64) uint64 {
+	var total uint64
+	for _, n := range networks {
+		total += field(n)
+	}
+	return total
+}
+
+func sumBlkioField(entries []types.BlkioStatEntry, op string) uint64 {
+	var total uint64
+	for _, e := range entries {
+		if strings.EqualFold(e.Op, op) {
+			total += e.Value
+		}
+	}
+	return total
+}
+
+// formatDockerCreated renders the SDK's Unix-seconds Created field using
+// createdTimeLayout, the same human-readable format the CRI backend uses,
+// so Container.Created means the same thing regardless of backend.
+func formatDockerCreated(unixSeconds int64) string {
+	return time.Unix(unixSeconds, 0).Format(createdTimeLayout)
+}
+
+func formatDockerPorts(ports []types.Port) string {
+	parts := make([]string, 0, len(ports))
+	for _, p := range ports {
+		if p.PublicPort != 0 {
+			parts = append(parts, fmt.Sprintf("%s:%d->%d/%s", p.IP, p.PublicPort, p.PrivatePort, p.Type))
+		} else {
+			parts = append(parts, fmt.Sprintf("%d/%s", p.PrivatePort, p.Type))
+		}
+	}
+	return strings.Join(parts, ", ")
+}
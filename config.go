@@ -0,0 +1,71 @@
+package main
+
+import (
+	"encoding/json"
+	"os"
+	"path/filepath"
+
+	tea "github.com/charmbracelet/bubbletea"
+)
+
+// config is persisted to ~/.config/dockerps/config.json so the chosen
+// sort/group mode survives across runs.
+type config struct {
+	SortMode       string `json:"sort_mode"`
+	GroupByProject bool   `json:"group_by_project"`
+}
+
+func configPath() (string, error) {
+	home, err := os.UserHomeDir()
+	if err != nil {
+		return "", err
+	}
+	return filepath.Join(home, ".config", "dockerps", "config.json"), nil
+}
+
+// loadConfig reads the persisted config, returning the zero value (and no
+// error) if it doesn't exist yet — first run shouldn't fail.
+func loadConfig() config {
+	path, err := configPath()
+	if err != nil {
+		return config{}
+	}
+
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return config{}
+	}
+
+	var cfg config
+	if err := json.Unmarshal(data, &cfg); err != nil {
+		return config{}
+	}
+	return cfg
+}
+
+// saveConfigCmd persists cfg as a tea.Cmd so callers never block Update on
+// disk I/O. Write failures are non-fatal — the in-memory setting still
+// applies for the rest of the session.
+func saveConfigCmd(cfg config) tea.Cmd {
+	return func() tea.Msg {
+		_ = saveConfig(cfg)
+		return nil
+	}
+}
+
+func saveConfig(cfg config) error {
+	path, err := configPath()
+	if err != nil {
+		return err
+	}
+
+	if err := os.MkdirAll(filepath.Dir(path), 0o755); err != nil {
+		return err
+	}
+
+	data, err := json.MarshalIndent(cfg, "", "  ")
+	if err != nil {
+		return err
+	}
+	return os.WriteFile(path, data, 0o644)
+}
@@ -0,0 +1,135 @@
+package main
+
+import (
+	"strings"
+
+	"github.com/charmbracelet/lipgloss"
+	"github.com/sahilm/fuzzy"
+)
+
+var highlightStyle = lipgloss.NewStyle().
+	Foreground(lipgloss.Color("205")).
+	Bold(true)
+
+// filterField identifies which composite-string segment a matched rune
+// index falls into, so highlighting can be applied per rendered cell
+// instead of against the composite string itself.
+type filterField int
+
+const (
+	fieldName filterField = iota
+	fieldImage
+	fieldID
+	fieldPorts
+	fieldState
+	fieldCount
+)
+
+// filteredContainer pairs a Container with the fuzzy-match rune indexes
+// that landed in each of its searchable fields, so the table can bold the
+// matched runes when rendering.
+type filteredContainer struct {
+	Container Container
+	matched   [fieldCount][]int
+}
+
+// containerFuzzySource adapts a []Container into fuzzy.Source by exposing
+// the composite "name image id ports state" string fuzzy matches against.
+type containerFuzzySource struct {
+	containers []Container
+	composites []string
+	offsets    [][fieldCount + 1]int
+}
+
+func newContainerFuzzySource(containers []Container) containerFuzzySource {
+	src := containerFuzzySource{containers: containers}
+	for _, c := range containers {
+		name := strings.TrimPrefix(c.Names, "/")
+		fields := [fieldCount]string{
+			fieldName:  name,
+			fieldImage: c.Image,
+			fieldID:    c.ID,
+			fieldPorts: c.Ports,
+			fieldState: c.State,
+		}
+
+		var b strings.Builder
+		var offset [fieldCount + 1]int
+		for i, f := range fields {
+			offset[i] = b.Len()
+			b.WriteString(f)
+			b.WriteByte(' ')
+		}
+		offset[fieldCount] = b.Len()
+
+		src.composites = append(src.composites, b.String())
+		src.offsets = append(src.offsets, offset)
+	}
+	return src
+}
+
+func (s containerFuzzySource) String(i int) string { return s.composites[i] }
+func (s containerFuzzySource) Len() int            { return len(s.composites) }
+
+// fieldForIndex maps a matched rune index in the composite string back to
+// which field it belongs to, and its offset within that field.
+func (s containerFuzzySource) fieldForIndex(containerIdx, runeIdx int) (filterField, int) {
+	offsets := s.offsets[containerIdx]
+	for f := fieldCount - 1; f >= 0; f-- {
+		if runeIdx >= offsets[f] {
+			return filterField(f), runeIdx - offsets[f]
+		}
+	}
+	return fieldName, runeIdx
+}
+
+// filterContainers fuzzy-matches containers against filter (searching name,
+// image, id, ports, and state), ranks them by fuzzy score descending, and
+// returns each match's rune-level hit positions per field for highlighting.
+// An empty filter preserves the original ordering untouched.
+func filterContainers(containers []Container, filter string) []filteredContainer {
+	if filter == "" {
+		out := make([]filteredContainer, len(containers))
+		for i, c := range containers {
+			out[i] = filteredContainer{Container: c}
+		}
+		return out
+	}
+
+	source := newContainerFuzzySource(containers)
+	matches := fuzzy.FindFrom(filter, source)
+
+	out := make([]filteredContainer, 0, len(matches))
+	for _, match := range matches {
+		fc := filteredContainer{Container: containers[match.Index]}
+		for _, runeIdx := range match.MatchedIndexes {
+			field, pos := source.fieldForIndex(match.Index, runeIdx)
+			fc.matched[field] = append(fc.matched[field], pos)
+		}
+		out = append(out, fc)
+	}
+	return out
+}
+
+// highlightRunes bolds the runes at the given positions in s, used to show
+// fuzzy-match hits inline in a rendered table cell.
+func highlightRunes(s string, positions []int) string {
+	if len(positions) == 0 {
+		return s
+	}
+
+	hit := make(map[int]bool, len(positions))
+	for _, p := range positions {
+		hit[p] = true
+	}
+
+	var b strings.Builder
+	for i, r := range []rune(s) {
+		if hit[i] {
+			b.WriteString(highlightStyle.Render(string(r)))
+		} else {
+			b.WriteRune(r)
+		}
+	}
+	return b.String()
+}
@@ -0,0 +1,50 @@
+package main
+
+import "testing"
+
+func TestFilterContainersEmptyFilterPreservesOrder(t *testing.T) {
+	containers := []Container{
+		{ID: "c1", Names: "/alpha"},
+		{ID: "c2", Names: "/beta"},
+	}
+
+	got := filterContainers(containers, "")
+
+	if len(got) != len(containers) {
+		t.Fatalf("got %d results, want %d", len(got), len(containers))
+	}
+	for i, c := range containers {
+		if got[i].Container.ID != c.ID {
+			t.Errorf("result[%d].ID = %q, want %q", i, got[i].Container.ID, c.ID)
+		}
+	}
+}
+
+func TestFilterContainersMatchesAcrossFields(t *testing.T) {
+	containers := []Container{
+		{ID: "abc123", Names: "/web", Image: "nginx"},
+		{ID: "def456", Names: "/db", Image: "postgres"},
+	}
+
+	got := filterContainers(containers, "nginx")
+
+	if len(got) != 1 {
+		t.Fatalf("got %d results, want 1", len(got))
+	}
+	if got[0].Container.ID != "abc123" {
+		t.Errorf("got container %q, want abc123", got[0].Container.ID)
+	}
+	if len(got[0].matched[fieldImage]) == 0 {
+		t.Error("expected matched rune positions in fieldImage")
+	}
+}
+
+func TestFilterContainersNoMatch(t *testing.T) {
+	containers := []Container{{ID: "abc123", Names: "/web"}}
+
+	got := filterContainers(containers, "zzz-nope")
+
+	if len(got) != 0 {
+		t.Fatalf("got %d results, want 0", len(got))
+	}
+}
@@ -0,0 +1,144 @@
+package main
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/charmbracelet/lipgloss"
+)
+
+var groupHeaderStyle = lipgloss.NewStyle().
+	Foreground(lipgloss.Color("33")).
+	Bold(true)
+
+// sortMode is cycled with `o`, mirroring the containerByCreated-style
+// comparators crictl uses for its own container listing.
+type sortMode int
+
+const (
+	sortCreated sortMode = iota
+	sortName
+	sortCPU
+	sortMemory
+	sortState
+)
+
+var sortModeNames = [...]string{"created", "name", "cpu", "memory", "state"}
+
+func (s sortMode) String() string {
+	if int(s) < len(sortModeNames) {
+		return sortModeNames[s]
+	}
+	return "unknown"
+}
+
+func sortModeFromString(s string) sortMode {
+	for i, name := range sortModeNames {
+		if name == s {
+			return sortMode(i)
+		}
+	}
+	return sortCreated
+}
+
+func (s sortMode) next() sortMode {
+	return sortMode((int(s) + 1) % len(sortModeNames))
+}
+
+// sortFiltered orders filtered in place according to mode. CPU/memory
+// sorting uses the most recent sample in stats, defaulting to 0 for
+// containers that haven't been sampled yet.
+func sortFiltered(filtered []filteredContainer, mode sortMode, stats map[string]Stats) {
+	sort.SliceStable(filtered, func(i, j int) bool {
+		a, b := filtered[i].Container, filtered[j].Container
+		switch mode {
+		case sortName:
+			return strings.ToLower(strings.TrimPrefix(a.Names, "/")) < strings.ToLower(strings.TrimPrefix(b.Names, "/"))
+		case sortState:
+			return a.State < b.State
+		case sortCPU:
+			return stats[a.ID].CPUPercent > stats[b.ID].CPUPercent
+		case sortMemory:
+			return stats[a.ID].MemUsage > stats[b.ID].MemUsage
+		default: // sortCreated
+			return a.Created > b.Created
+		}
+	})
+}
+
+// groupKeyFor returns the Compose project (Docker) or pod name (CRI) a
+// container belongs to, or "" if it isn't part of either.
+func groupKeyFor(c Container) string {
+	if p := c.Labels["com.docker.compose.project"]; p != "" {
+		return p
+	}
+	if p := c.Labels["io.kubernetes.pod.name"]; p != "" {
+		return p
+	}
+	return ""
+}
+
+// tableRow is one rendered row: either a collapsible group header or a
+// regular container row. Keeping both in one flat slice lets them share the
+// same bubbles/table cursor and selection logic.
+type tableRow struct {
+	isHeader bool
+	groupKey string
+	summary  string
+	fc       filteredContainer
+}
+
+// groupRows arranges filtered into table rows, inserting an aggregate
+// header ("myapp — 3 running / 1 stopped") before each group's members
+// when grouping is enabled. Collapsed groups render only their header.
+func groupRows(filtered []filteredContainer, groupByProject bool, collapsed map[string]bool) []tableRow {
+	if !groupByProject {
+		rows := make([]tableRow, len(filtered))
+		for i, fc := range filtered {
+			rows[i] = tableRow{fc: fc}
+		}
+		return rows
+	}
+
+	var order []string
+	groups := map[string][]filteredContainer{}
+	for _, fc := range filtered {
+		key := groupKeyFor(fc.Container)
+		if _, seen := groups[key]; !seen {
+			order = append(order, key)
+		}
+		groups[key] = append(groups[key], fc)
+	}
+
+	var rows []tableRow
+	for _, key := range order {
+		members := groups[key]
+		running, stopped := 0, 0
+		for _, fc := range members {
+			if fc.Container.State == "running" {
+				running++
+			} else {
+				stopped++
+			}
+		}
+
+		label := key
+		if label == "" {
+			label = "(ungrouped)"
+		}
+		rows = append(rows, tableRow{
+			isHeader: true,
+			groupKey: key,
+			summary:  fmt.Sprintf("%s — %d running / %d stopped", label, running, stopped),
+		})
+
+		if collapsed[key] {
+			continue
+		}
+		for _, fc := range members {
+			rows = append(rows, tableRow{fc: fc, groupKey: key})
+		}
+	}
+	return rows
+}
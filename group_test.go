@@ -0,0 +1,65 @@
+package main
+
+import "testing"
+
+func TestGroupKeyFor(t *testing.T) {
+	tests := []struct {
+		name string
+		c    Container
+		want string
+	}{
+		{
+			name: "compose project label wins",
+			c:    Container{Labels: map[string]string{"com.docker.compose.project": "myapp"}},
+			want: "myapp",
+		},
+		{
+			name: "falls back to pod name label",
+			c:    Container{Labels: map[string]string{"io.kubernetes.pod.name": "mypod"}},
+			want: "mypod",
+		},
+		{
+			name: "no grouping labels",
+			c:    Container{Labels: map[string]string{}},
+			want: "",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := groupKeyFor(tt.c); got != tt.want {
+				t.Errorf("groupKeyFor() = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestSortFilteredByName(t *testing.T) {
+	filtered := []filteredContainer{
+		{Container: Container{ID: "1", Names: "/Zebra"}},
+		{Container: Container{ID: "2", Names: "/apple"}},
+	}
+
+	sortFiltered(filtered, sortName, nil)
+
+	if filtered[0].Container.ID != "2" {
+		t.Errorf("expected apple first, got %s", filtered[0].Container.Names)
+	}
+}
+
+func TestSortFilteredByCPU(t *testing.T) {
+	filtered := []filteredContainer{
+		{Container: Container{ID: "1"}},
+		{Container: Container{ID: "2"}},
+	}
+	stats := map[string]Stats{
+		"1": {CPUPercent: 10},
+		"2": {CPUPercent: 90},
+	}
+
+	sortFiltered(filtered, sortCPU, stats)
+
+	if filtered[0].Container.ID != "2" {
+		t.Errorf("expected container 2 (higher CPU) first, got %s", filtered[0].Container.ID)
+	}
+}
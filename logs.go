@@ -0,0 +1,258 @@
+package main
+
+import (
+	"bufio"
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/charmbracelet/bubbles/textinput"
+	"github.com/charmbracelet/bubbles/viewport"
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/lipgloss"
+)
+
+var (
+	logsTitleStyle = lipgloss.NewStyle().
+			Foreground(lipgloss.Color("86")).
+			Bold(true).
+			Padding(0, 1)
+
+	logsFollowStyle = lipgloss.NewStyle().
+			Foreground(lipgloss.Color("82")).
+			Bold(true)
+
+	logsPausedStyle = lipgloss.NewStyle().
+			Foreground(lipgloss.Color("226")).
+			Bold(true)
+)
+
+// logLineMsg carries a single line read from the Backend's log stream.
+type logLineMsg string
+
+// logsStreamClosed is sent once the Backend's log stream ends, whether
+// because the container stopped or the view was closed.
+type logsStreamClosed struct {
+	err error
+}
+
+// logsStreamStarted carries the plumbing needed to keep reading lines from
+// the Backend's log stream after it has been opened.
+type logsStreamStarted struct {
+	cancel context.CancelFunc
+	lines  chan string
+	done   chan error
+}
+
+// logsModel is the sub-model shown when a container's logs are opened with
+// `l`. It owns its own viewport + filter textinput and streams lines in via
+// a goroutine feeding a channel, matching the bubbletea long-running-command
+// pattern (a tea.Cmd blocks on the channel and is re-issued after each msg).
+type logsModel struct {
+	backend       Backend
+	containerID   string
+	containerName string
+
+	viewport  viewport.Model
+	filter    textinput.Model
+	filtering bool
+	follow    bool
+
+	allLines []string
+	cancel   context.CancelFunc
+	lines    chan string
+	done     chan error
+
+	width, height int
+}
+
+func newLogsModel(backend Backend, containerID, containerName string, width, height int) logsModel {
+	filter := textinput.New()
+	filter.Placeholder = "Filter log lines..."
+	filter.CharLimit = 80
+
+	vp := viewport.New(width, height)
+	vp.YPosition = 0
+
+	return logsModel{
+		backend:       backend,
+		containerID:   containerID,
+		containerName: containerName,
+		viewport:      vp,
+		filter:        filter,
+		follow:        true,
+		width:         width,
+		height:        height,
+	}
+}
+
+// startLogsStream opens the container's log stream through the active
+// Backend and pumps it into a channel, returning a logsStreamStarted
+// message once the stream is up. The stream is cancelled via the returned
+// context, which closes the backend's reader.
+func startLogsStream(backend Backend, containerID string) tea.Cmd {
+	return func() tea.Msg {
+		ctx, cancel := context.WithCancel(context.Background())
+
+		rc, err := backend.Logs(ctx, containerID)
+		if err != nil {
+			cancel()
+			return logsStreamClosed{err}
+		}
+
+		lines := make(chan string, 256)
+		done := make(chan error, 1)
+
+		go func() {
+			scanner := bufio.NewScanner(rc)
+			scanner.Buffer(make([]byte, 64*1024), 1024*1024)
+			for scanner.Scan() {
+				lines <- scanner.Text()
+			}
+			done <- rc.Close()
+			close(lines)
+		}()
+
+		return logsStreamStarted{cancel: cancel, lines: lines, done: done}
+	}
+}
+
+// waitForLogLine blocks on the channel until a new line arrives or the
+// stream closes, then re-arms itself via the caller issuing the returned
+// tea.Cmd again after each message.
+func waitForLogLine(lines chan string, done chan error) tea.Cmd {
+	return func() tea.Msg {
+		line, ok := <-lines
+		if !ok {
+			return logsStreamClosed{err: <-done}
+		}
+		return logLineMsg(line)
+	}
+}
+
+func (m logsModel) matchesFilter(line string) bool {
+	if m.filter.Value() == "" {
+		return true
+	}
+	return strings.Contains(strings.ToLower(line), strings.ToLower(m.filter.Value()))
+}
+
+func (m logsModel) visibleLines() []string {
+	if m.filter.Value() == "" {
+		return m.allLines
+	}
+	var out []string
+	for _, l := range m.allLines {
+		if m.matchesFilter(l) {
+			out = append(out, l)
+		}
+	}
+	return out
+}
+
+func (m logsModel) render() string {
+	m.viewport.SetContent(strings.Join(m.visibleLines(), "\n"))
+	if m.follow {
+		m.viewport.GotoBottom()
+	}
+	return m.viewport.View()
+}
+
+func (m logsModel) Update(msg tea.Msg) (logsModel, tea.Cmd) {
+	switch msg := msg.(type) {
+	case logsStreamStarted:
+		m.cancel = msg.cancel
+		m.lines = msg.lines
+		m.done = msg.done
+		return m, waitForLogLine(m.lines, m.done)
+
+	case logLineMsg:
+		m.allLines = append(m.allLines, string(msg))
+		if m.lines != nil {
+			return m, waitForLogLine(m.lines, m.done)
+		}
+		return m, nil
+
+	case logsStreamClosed:
+		if msg.err != nil {
+			m.allLines = append(m.allLines, fmt.Sprintf("[stream closed: %v]", msg.err))
+		} else {
+			m.allLines = append(m.allLines, "[stream closed]")
+		}
+		return m, nil
+
+	case tea.KeyMsg:
+		if m.filtering {
+			switch msg.String() {
+			case "esc":
+				m.filtering = false
+				m.filter.Blur()
+				return m, nil
+			case "enter":
+				m.filtering = false
+				m.filter.Blur()
+				return m, nil
+			default:
+				var cmd tea.Cmd
+				m.filter, cmd = m.filter.Update(msg)
+				return m, cmd
+			}
+		}
+
+		switch msg.String() {
+		case "/":
+			m.filtering = true
+			return m, m.filter.Focus()
+		case "f":
+			m.follow = !m.follow
+			return m, nil
+		default:
+			var cmd tea.Cmd
+			m.viewport.SetContent(strings.Join(m.visibleLines(), "\n"))
+			if m.follow {
+				m.viewport.GotoBottom()
+			}
+			m.viewport, cmd = m.viewport.Update(msg)
+			if msg.String() == "up" || msg.String() == "down" || msg.String() == "k" || msg.String() == "j" {
+				m.follow = false
+			}
+			return m, cmd
+		}
+	}
+
+	var cmd tea.Cmd
+	m.viewport, cmd = m.viewport.Update(msg)
+	return m, cmd
+}
+
+func (m logsModel) View() string {
+	var b strings.Builder
+
+	b.WriteString(logsTitleStyle.Render(fmt.Sprintf("📜 Logs: %s", m.containerName)))
+	if m.follow {
+		b.WriteString(" " + logsFollowStyle.Render("[following]"))
+	} else {
+		b.WriteString(" " + logsPausedStyle.Render("[scroll]"))
+	}
+	b.WriteString("\n\n")
+
+	if m.filtering || m.filter.Value() != "" {
+		b.WriteString(filterStyle.Render("Filter: "))
+		b.WriteString(m.filter.View())
+		b.WriteString("\n\n")
+	}
+
+	b.WriteString(m.render())
+	b.WriteString("\n\n")
+
+	b.WriteString(helpStyle.Render("↑↓: scroll • f: toggle follow • /: filter • esc: back"))
+	return b.String()
+}
+
+// stop cancels the context the log stream was opened with. Safe to call
+// even if the stream never started.
+func (m logsModel) stop() {
+	if m.cancel != nil {
+		m.cancel()
+	}
+}
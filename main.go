@@ -1,10 +1,10 @@
 package main
 
 import (
-	"encoding/json"
+	"context"
+	"flag"
 	"fmt"
 	"os"
-	"os/exec"
 	"strings"
 	"time"
 
@@ -43,20 +43,31 @@ var (
 	runningStyle = lipgloss.NewStyle().Foreground(lipgloss.Color("82"))
 	stoppedStyle = lipgloss.NewStyle().Foreground(lipgloss.Color("196"))
 	pausedStyle  = lipgloss.NewStyle().Foreground(lipgloss.Color("226"))
+
+	confirmStyle = lipgloss.NewStyle().
+			Foreground(lipgloss.Color("196")).
+			Bold(true).
+			Padding(0, 1).
+			Border(lipgloss.RoundedBorder()).
+			BorderForeground(lipgloss.Color("196"))
 )
 
-type Container struct {
-	ID      string `json:"ID"`
-	Image   string `json:"Image"`
-	Command string `json:"Command"`
-	Created string `json:"CreatedAt"`
-	Status  string `json:"Status"`
-	Ports   string `json:"Ports"`
-	Names   string `json:"Names"`
-	State   string `json:"State"`
-}
+// viewState tracks which sub-model currently owns Update/View. The model
+// started as a single table and now acts as a small controller dispatching
+// to the list, logs, stats, and command-palette sub-models.
+type viewState int
+
+const (
+	viewList viewState = iota
+	viewLogs
+	viewStats
+	viewPalette
+)
 
 type model struct {
+	view viewState
+
+	backend    Backend
 	table      table.Model
 	containers []Container
 	filter     textinput.Model
@@ -66,68 +77,59 @@ type model struct {
 	height     int
 	statusMsg  string
 	loading    bool
+
+	logs    logsModel
+	stats   statsModel
+	palette paletteModel
+
+	// confirming blocks all other input until y/N is answered, guarding
+	// destructive actions (delete, kill, prune, compose down) from firing
+	// with no way back.
+	confirming     bool
+	confirmMessage string
+	confirmCmd     tea.Cmd
+
+	sortMode        sortMode
+	groupByProject  bool
+	collapsedGroups map[string]bool
+	containerStats  map[string]Stats
 }
 
 func (m model) Init() tea.Cmd {
-	return tea.Batch(loadContainers, textinput.Blink)
+	return tea.Batch(loadContainers(m.backend), textinput.Blink)
 }
 
-func loadContainers() tea.Msg {
-	cmd := exec.Command("docker", "ps", "-a", "--format", "json")
-	output, err := cmd.Output()
-	if err != nil {
-		return errMsg{err}
-	}
-
-	var containers []Container
-	lines := strings.Split(strings.TrimSpace(string(output)), "\n")
-
-	for _, line := range lines {
-		if line == "" {
-			continue
-		}
-		var container Container
-		if err := json.Unmarshal([]byte(line), &container); err != nil {
-			continue
+func loadContainers(b Backend) tea.Cmd {
+	return func() tea.Msg {
+		containers, err := b.List(context.Background())
+		if err != nil {
+			return errMsg{err}
 		}
-		containers = append(containers, container)
+		return containersLoaded{containers}
 	}
-
-	return containersLoaded{containers}
 }
 
-func startContainer(containerID string) tea.Cmd {
+func startContainer(b Backend, containerID string) tea.Cmd {
 	return func() tea.Msg {
-		cmd := exec.Command("docker", "start", containerID)
-		err := cmd.Run()
-		if err != nil {
+		if err := b.Start(context.Background(), containerID); err != nil {
 			return actionResult{success: false, message: fmt.Sprintf("Failed to start container: %v", err)}
 		}
 		return actionResult{success: true, message: fmt.Sprintf("Container %s started successfully", containerID[:12])}
 	}
 }
 
-func stopContainer(containerID string) tea.Cmd {
+func stopContainer(b Backend, containerID string) tea.Cmd {
 	return func() tea.Msg {
-		cmd := exec.Command("docker", "stop", containerID)
-		err := cmd.Run()
-		if err != nil {
+		if err := b.Stop(context.Background(), containerID); err != nil {
 			return actionResult{success: false, message: fmt.Sprintf("Failed to stop container: %v", err)}
 		}
 		return actionResult{success: true, message: fmt.Sprintf("Container %s stopped successfully", containerID[:12])}
 	}
 }
 
-func deleteContainer(containerID string) tea.Cmd {
+func deleteContainer(b Backend, containerID string) tea.Cmd {
 	return func() tea.Msg {
-		// First stop the container if it's running
-		stopCmd := exec.Command("docker", "stop", containerID)
-		stopCmd.Run() // Ignore error - container might already be stopped
-
-		// Then remove it
-		cmd := exec.Command("docker", "rm", containerID)
-		err := cmd.Run()
-		if err != nil {
+		if err := b.Remove(context.Background(), containerID); err != nil {
 			return actionResult{success: false, message: fmt.Sprintf("Failed to delete container: %v", err)}
 		}
 		return actionResult{success: true, message: fmt.Sprintf("Container %s deleted successfully", containerID[:12])}
@@ -184,8 +186,7 @@ func formatTime(created string) string {
 		return "—"
 	}
 
-	// Try to parse Docker timestamp
-	t, err := time.Parse("2006-01-02 15:04:05 -0700 MST", created)
+	t, err := time.Parse(createdTimeLayout, created)
 	if err != nil {
 		return created[:10] // Just show date part if parsing fails
 	}
@@ -208,80 +209,165 @@ func formatPorts(ports string) string {
 	return truncate(strings.ReplaceAll(ports, "0.0.0.0:", ""), 25)
 }
 
-func (m model) containerToRow(c Container) table.Row {
+func (m model) containerToRow(row tableRow) table.Row {
+	if row.isHeader {
+		marker := "▾"
+		if m.collapsedGroups[row.groupKey] {
+			marker = "▸"
+		}
+		return table.Row{groupHeaderStyle.Render(marker + " " + row.summary), "", "", "", ""}
+	}
+
+	fc := row.fc
+	c := fc.Container
 	// Clean container name (remove leading slash if present)
 	name := strings.TrimPrefix(c.Names, "/")
+	ports := formatPorts(c.Ports)
 
 	// Get column widths from current table configuration
 	cols := m.table.Columns()
-	if len(cols) < 5 {
-		// Fallback to default widths
-		return table.Row{
-			truncate(c.ID, 14),
-			truncate(name, 25),
-			truncate(c.Image, 30),
-			formatStatus(c.State), // Don't truncate status - preserve color formatting
-			truncate(formatPorts(c.Ports), 25),
-		}
+	idWidth, nameWidth, imageWidth, portsWidth := 14, 25, 30, 25
+	if len(cols) >= 5 {
+		idWidth, nameWidth, imageWidth, portsWidth = cols[0].Width, cols[1].Width, cols[2].Width, cols[4].Width
 	}
 
+	// Truncate before highlighting so bolded runes aren't cut mid-escape-code.
 	return table.Row{
-		truncate(c.ID, cols[0].Width),
-		truncate(name, cols[1].Width),
-		truncate(c.Image, cols[2].Width),
+		highlightRunes(truncate(c.ID, idWidth), fc.matched[fieldID]),
+		highlightRunes(truncate(name, nameWidth), fc.matched[fieldName]),
+		highlightRunes(truncate(c.Image, imageWidth), fc.matched[fieldImage]),
 		formatStatus(c.State), // Don't truncate status - preserve color formatting
-		truncate(formatPorts(c.Ports), cols[4].Width),
+		highlightRunes(truncate(ports, portsWidth), fc.matched[fieldPorts]),
 	}
 }
 
-func filterContainers(containers []Container, filter string) []Container {
-	if filter == "" {
-		return containers
-	}
-
-	var filtered []Container
-	filter = strings.ToLower(filter)
-
-	for _, container := range containers {
-		name := strings.ToLower(strings.TrimPrefix(container.Names, "/"))
-		ports := strings.ToLower(container.Ports)
-
-		if strings.Contains(name, filter) ||
-			strings.Contains(strings.ToLower(container.Image), filter) ||
-			strings.Contains(strings.ToLower(container.State), filter) ||
-			strings.Contains(strings.ToLower(container.ID), filter) ||
-			strings.Contains(ports, filter) {
-			filtered = append(filtered, container)
-		}
+// visibleRows applies the active filter, sort mode, and grouping to build
+// the exact row set rendered in the table, so the table's cursor index can
+// be mapped back to a container (or a group header) unambiguously. The sort
+// mode is applied only when the filter is empty; a non-empty filter keeps
+// the fuzzy-score ranking.
+func (m model) visibleRows() []tableRow {
+	filtered := filterContainers(m.containers, m.filter.Value())
+	if m.filter.Value() == "" {
+		sortFiltered(filtered, m.sortMode, m.containerStats)
 	}
-
-	return filtered
+	return groupRows(filtered, m.groupByProject, m.collapsedGroups)
 }
 
 func (m model) getSelectedContainer() *Container {
-	if len(m.containers) == 0 {
+	rows := m.visibleRows()
+	cursor := m.table.Cursor()
+	if cursor < 0 || cursor >= len(rows) || rows[cursor].isHeader {
 		return nil
 	}
+	return &rows[cursor].fc.Container
+}
 
-	filtered := filterContainers(m.containers, m.filter.Value())
-	if len(filtered) == 0 {
-		return nil
+// runPaletteCommand interprets a submitted command-palette entry against
+// the currently selected container. The cases that go through confirm
+// (kill, prune, compose up/down) are gated behind the confirmation modal
+// only when their paletteCommand.Destructive flag is set; the other cases
+// run their command immediately whatever the flag says.
+func (m model) runPaletteCommand(msg paletteSubmitMsg) (tea.Model, tea.Cmd) {
+	container := m.getSelectedContainer()
+
+	refresh := func(cmd tea.Cmd) tea.Cmd {
+		return tea.Batch(cmd, tea.Tick(time.Second*2, func(t time.Time) tea.Msg {
+			return loadContainers(m.backend)()
+		}))
 	}
 
-	cursor := m.table.Cursor()
-	if cursor >= len(filtered) {
-		return nil
+	destructive := false
+	if pc, ok := paletteCommandByName(msg.command); ok {
+		destructive = pc.Destructive
+	}
+
+	// confirm runs cmd immediately for non-destructive commands, or gates
+	// it behind the y/N modal for destructive ones.
+	confirm := func(message string, cmd tea.Cmd) (tea.Model, tea.Cmd) {
+		if !destructive {
+			return m, cmd
+		}
+		m.confirming = true
+		m.confirmMessage = message
+		m.confirmCmd = cmd
+		return m, nil
 	}
 
-	return &filtered[cursor]
+	switch msg.command {
+	case "restart":
+		if container == nil {
+			return m, nil
+		}
+		m.statusMsg = fmt.Sprintf("Restarting container %s...", container.ID[:12])
+		m.loading = true
+		return m, refresh(restartContainer(m.backend, container.ID))
+
+	case "pause":
+		if container == nil {
+			return m, nil
+		}
+		return m, pauseContainer(m.backend, container.ID)
+
+	case "unpause":
+		if container == nil {
+			return m, nil
+		}
+		return m, unpauseContainer(m.backend, container.ID)
+
+	case "kill":
+		if container == nil {
+			return m, nil
+		}
+		signal := msg.arg
+		var message string
+		if signal == "" {
+			message = fmt.Sprintf("Kill container %s?", truncate(strings.TrimPrefix(container.Names, "/"), 40))
+		} else {
+			message = fmt.Sprintf("Kill container %s with signal %s?", truncate(strings.TrimPrefix(container.Names, "/"), 40), signal)
+		}
+		return confirm(message, refresh(killContainer(m.backend, container.ID, signal)))
+
+	case "rename":
+		if container == nil {
+			return m, nil
+		}
+		return m, refresh(renameContainer(m.backend, container.ID, msg.arg))
+
+	case "exec":
+		if container == nil {
+			return m, nil
+		}
+		return m, execIntoContainer(m.backend, container.ID, msg.arg)
+
+	case "inspect":
+		if container == nil {
+			return m, nil
+		}
+		return m, inspectContainer(m.backend, container.ID, strings.TrimPrefix(container.Names, "/"))
+
+	case "prune":
+		return confirm("Remove all stopped containers?", refresh(pruneContainers(m.backend)))
+
+	case "compose up", "compose down":
+		action := strings.TrimPrefix(msg.command, "compose ")
+		project := ""
+		if container != nil {
+			project = container.Labels["com.docker.compose.project"]
+		}
+		cmd := refresh(composeProject(m.backend, project, action))
+		return confirm(fmt.Sprintf("Tear down compose project %q?", project), cmd)
+
+	default:
+		m.statusMsg = fmt.Sprintf("Unknown command: %s", msg.command)
+		return m, nil
+	}
 }
 
 func (m model) updateTable() model {
-	filtered := filterContainers(m.containers, m.filter.Value())
-
 	var rows []table.Row
-	for _, container := range filtered {
-		rows = append(rows, m.containerToRow(container))
+	for _, row := range m.visibleRows() {
+		rows = append(rows, m.containerToRow(row))
 	}
 
 	m.table.SetRows(rows)
@@ -342,6 +428,50 @@ func (m model) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 		return m, nil
 
 	case tea.KeyMsg:
+		if m.confirming {
+			switch msg.String() {
+			case "y", "Y":
+				cmd := m.confirmCmd
+				m.confirming = false
+				m.confirmMessage = ""
+				m.confirmCmd = nil
+				return m, cmd
+			default:
+				m.confirming = false
+				m.confirmMessage = ""
+				m.confirmCmd = nil
+				return m, nil
+			}
+		}
+
+		if m.view == viewLogs {
+			if msg.String() == "esc" {
+				m.logs.stop()
+				m.view = viewList
+				return m, nil
+			}
+			var cmd tea.Cmd
+			m.logs, cmd = m.logs.Update(msg)
+			return m, cmd
+		}
+
+		if m.view == viewStats {
+			if msg.String() == "esc" {
+				m.stats.stop()
+				m.view = viewList
+				return m, nil
+			}
+			var cmd tea.Cmd
+			m.stats, cmd = m.stats.Update(msg)
+			return m, cmd
+		}
+
+		if m.view == viewPalette {
+			var cmd tea.Cmd
+			m.palette, cmd = m.palette.Update(msg)
+			return m, cmd
+		}
+
 		if m.filtering {
 			switch msg.String() {
 			case "ctrl+c":
@@ -369,7 +499,7 @@ func (m model) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 			case "r":
 				m.statusMsg = "Refreshing containers..."
 				m.loading = true
-				return m, loadContainers
+				return m, loadContainers(m.backend)
 			case "s":
 				// Start container
 				container := m.getSelectedContainer()
@@ -379,9 +509,9 @@ func (m model) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 					} else {
 						m.statusMsg = fmt.Sprintf("Starting container %s...", container.ID[:12])
 						m.loading = true
-						return m, tea.Batch(startContainer(container.ID),
+						return m, tea.Batch(startContainer(m.backend, container.ID),
 							tea.Tick(time.Second*2, func(t time.Time) tea.Msg {
-								return loadContainers()
+								return loadContainers(m.backend)()
 							}))
 					}
 				}
@@ -395,31 +525,141 @@ func (m model) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 					} else {
 						m.statusMsg = fmt.Sprintf("Stopping container %s...", container.ID[:12])
 						m.loading = true
-						return m, tea.Batch(stopContainer(container.ID),
+						return m, tea.Batch(stopContainer(m.backend, container.ID),
 							tea.Tick(time.Second*2, func(t time.Time) tea.Msg {
-								return loadContainers()
+								return loadContainers(m.backend)()
 							}))
 					}
 				}
 				return m, nil
 			case "d":
-				// Delete container
+				// Delete container — confirm first, this can't be undone
 				container := m.getSelectedContainer()
 				if container != nil {
-					m.statusMsg = fmt.Sprintf("Deleting container %s...", container.ID[:12])
-					m.loading = true
-					return m, tea.Batch(deleteContainer(container.ID),
+					name := strings.TrimPrefix(container.Names, "/")
+					m.confirming = true
+					m.confirmMessage = fmt.Sprintf("Delete container %s?", truncate(name, 40))
+					m.confirmCmd = tea.Batch(deleteContainer(m.backend, container.ID),
 						tea.Tick(time.Second*2, func(t time.Time) tea.Msg {
-							return loadContainers()
+							return loadContainers(m.backend)()
 						}))
 				}
 				return m, nil
+			case "l":
+				// Open streaming logs for the selected container
+				container := m.getSelectedContainer()
+				if container != nil {
+					name := strings.TrimPrefix(container.Names, "/")
+					m.logs = newLogsModel(m.backend, container.ID, name, m.width, max(10, m.height-8))
+					m.view = viewLogs
+					return m, startLogsStream(m.backend, container.ID)
+				}
+				return m, nil
+			case "t":
+				// Open the live stats dashboard for the selected container
+				container := m.getSelectedContainer()
+				if container != nil {
+					name := strings.TrimPrefix(container.Names, "/")
+					m.stats = newStatsModel(m.backend, container.ID, name, m.width, max(10, m.height-8))
+					m.stats.allContainers = m.containers
+					m.view = viewStats
+					return m, startStatsStream(m.backend, container.ID)
+				}
+				return m, nil
+			case ":":
+				// Open the command palette
+				m.palette = newPaletteModel()
+				m.view = viewPalette
+				return m, textinput.Blink
+			case "o":
+				// Cycle sort mode: created, name, cpu, memory, state
+				m.sortMode = m.sortMode.next()
+				m.statusMsg = fmt.Sprintf("Sorting by %s", m.sortMode)
+				m = m.updateTable()
+				cmds := []tea.Cmd{saveConfigCmd(config{SortMode: m.sortMode.String(), GroupByProject: m.groupByProject})}
+				if m.sortMode == sortCPU || m.sortMode == sortMemory {
+					cmds = append(cmds, pollOverviewSample(m.backend, m.containers))
+				}
+				return m, tea.Batch(cmds...)
+			case "g":
+				// On a group header, toggle that group's collapse; otherwise
+				// toggle grouping by Compose project / pod on and off.
+				rows := m.visibleRows()
+				cursor := m.table.Cursor()
+				if m.groupByProject && cursor >= 0 && cursor < len(rows) && rows[cursor].isHeader {
+					if m.collapsedGroups == nil {
+						m.collapsedGroups = map[string]bool{}
+					}
+					m.collapsedGroups[rows[cursor].groupKey] = !m.collapsedGroups[rows[cursor].groupKey]
+				} else {
+					m.groupByProject = !m.groupByProject
+				}
+				return m.updateTable(), saveConfigCmd(config{SortMode: m.sortMode.String(), GroupByProject: m.groupByProject})
 			default:
 				m.table, cmd = m.table.Update(msg)
 				return m, cmd
 			}
 		}
 
+	case logsStreamStarted, logLineMsg, logsStreamClosed:
+		if m.view == viewLogs {
+			var cmd tea.Cmd
+			m.logs, cmd = m.logs.Update(msg)
+			return m, cmd
+		}
+		return m, nil
+
+	case overviewSampleMsg:
+		// Feeds both the stats overview panel and list-view cpu/memory
+		// sorting: the samples are cached in m.containerStats for sorting
+		// and also forwarded to the stats model for its overview rows.
+		if m.containerStats == nil {
+			m.containerStats = map[string]Stats{}
+		}
+		for _, s := range msg {
+			m.containerStats[s.ContainerID] = s
+		}
+
+		if m.view == viewStats {
+			var cmd tea.Cmd
+			m.stats, cmd = m.stats.Update(msg)
+			return m, cmd
+		}
+		if m.sortMode == sortCPU || m.sortMode == sortMemory {
+			m = m.updateTable()
+			return m, tea.Tick(statsTickInterval, func(t time.Time) tea.Msg {
+				return pollOverviewSample(m.backend, m.containers)()
+			})
+		}
+		return m, nil
+
+	case statsStreamStarted, statsSampleMsg, statsStreamClosed, reconnectStatsMsg, statsTickMsg:
+		if m.view == viewStats {
+			var cmd tea.Cmd
+			m.stats, cmd = m.stats.Update(msg)
+			return m, cmd
+		}
+		return m, nil
+
+	case paletteClosedMsg:
+		m.view = viewList
+		return m, nil
+
+	case paletteSubmitMsg:
+		m.view = viewList
+		return m.runPaletteCommand(msg)
+
+	case inspectResultMsg:
+		if msg.err != nil {
+			m.statusMsg = fmt.Sprintf("Failed to inspect container: %v", msg.err)
+			return m, nil
+		}
+		m.logs = newLogsModel(m.backend, "", msg.name, m.width, max(10, m.height-8))
+		m.logs.allLines = strings.Split(msg.output, "\n")
+		m.logs.follow = false
+		m.view = viewLogs
+		return m, nil
+
 	case containersLoaded:
 		m.containers = msg.containers
 		m = m.updateTable()
@@ -448,6 +688,16 @@ func (m model) View() string {
 		return fmt.Sprintf("Error: %v\n", m.err)
 	}
 
+	if m.view == viewLogs {
+		return m.logs.View()
+	}
+	if m.view == viewStats {
+		return m.stats.View()
+	}
+	if m.view == viewPalette {
+		return m.palette.View()
+	}
+
 	var b strings.Builder
 
 	// Title with container count
@@ -468,6 +718,12 @@ func (m model) View() string {
 		b.WriteString("\n\n")
 	}
 
+	// Confirmation modal blocks everything else below it
+	if m.confirming {
+		b.WriteString(confirmStyle.Render(m.confirmMessage + "\n\n[y] confirm   [n/any] cancel"))
+		b.WriteString("\n\n")
+	}
+
 	// Status message
 	if m.statusMsg != "" {
 		if strings.Contains(m.statusMsg, "successfully") {
@@ -488,14 +744,14 @@ func (m model) View() string {
 	if m.filtering {
 		b.WriteString(helpStyle.Render("Enter: apply filter • Esc: cancel • Ctrl+C: quit"))
 	} else {
-		b.WriteString(helpStyle.Render("↑↓: navigate • s: start • x: stop • d: delete • /: filter • r: refresh • q: quit"))
+		b.WriteString(helpStyle.Render(fmt.Sprintf("↑↓: navigate • s: start • x: stop • d: delete • l: logs • t: stats • :: commands • o: sort (%s) • g: group • /: filter • r: refresh • q: quit", m.sortMode)))
 	}
 	b.WriteString("\n")
 
 	return b.String()
 }
 
-func initialModel() model {
+func initialModel(backend Backend) model {
 	// Create filter input
 	filter := textinput.New()
 	filter.Placeholder = "Type to filter containers..."
@@ -535,19 +791,34 @@ func initialModel() model {
 
 	t.SetStyles(s)
 
+	cfg := loadConfig()
+
 	return model{
-		table:  t,
-		filter: filter,
-		width:  100, // Better default width
-		height: 30,  // Better default height
+		backend:         backend,
+		table:           t,
+		filter:          filter,
+		width:           100, // Better default width
+		height:          30,  // Better default height
+		sortMode:        sortModeFromString(cfg.SortMode),
+		groupByProject:  cfg.GroupByProject,
+		collapsedGroups: map[string]bool{},
+		containerStats:  map[string]Stats{},
 	}
 }
 
 func main() {
-	p := tea.NewProgram(initialModel(), tea.WithAltScreen())
+	runtime := flag.String("runtime", "", "container runtime backend to use: docker, cri (default: auto-detect)")
+	flag.Parse()
+
+	backend, err := NewBackend(*runtime)
+	if err != nil {
+		fmt.Printf("Error: %v\n", err)
+		os.Exit(1)
+	}
+
+	p := tea.NewProgram(initialModel(backend), tea.WithAltScreen())
 	if _, err := p.Run(); err != nil {
 		fmt.Printf("Error: %v", err)
 		os.Exit(1)
 	}
 }
-
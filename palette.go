@@ -0,0 +1,290 @@
+package main
+
+import (
+	"context"
+	"fmt"
+	"os/exec"
+	"strings"
+
+	"github.com/charmbracelet/bubbles/textinput"
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/lipgloss"
+)
+
+var (
+	paletteTitleStyle = lipgloss.NewStyle().
+				Foreground(lipgloss.Color("86")).
+				Bold(true)
+
+	paletteItemStyle = lipgloss.NewStyle().
+				Foreground(lipgloss.Color("241"))
+
+	paletteSelectedStyle = lipgloss.NewStyle().
+				Foreground(lipgloss.Color("229")).
+				Background(lipgloss.Color("57")).
+				Bold(true)
+)
+
+// paletteCommand describes one command-palette entry. Destructive commands
+// are routed through the confirmation modal instead of running immediately.
+type paletteCommand struct {
+	Name        string
+	Usage       string
+	Destructive bool
+}
+
+// paletteCommandByName looks up a command-palette entry by its exact Name,
+// used by the controller to decide whether a submitted command needs
+// confirmation without duplicating the Destructive list.
+func paletteCommandByName(name string) (paletteCommand, bool) {
+	for _, c := range paletteCommands {
+		if c.Name == name {
+			return c, true
+		}
+	}
+	return paletteCommand{}, false
+}
+
+var paletteCommands = []paletteCommand{
+	{Name: "restart", Usage: "restart the selected container"},
+	{Name: "pause", Usage: "pause the selected container"},
+	{Name: "unpause", Usage: "unpause the selected container"},
+	{Name: "kill", Usage: "kill [signal] (default KILL)", Destructive: true},
+	{Name: "rename", Usage: "rename <new-name>"},
+	{Name: "exec", Usage: "exec <cmd> (default /bin/sh)"},
+	{Name: "inspect", Usage: "inspect — show the raw container JSON"},
+	{Name: "prune", Usage: "prune — remove all stopped containers", Destructive: true},
+	{Name: "compose up", Usage: "bring the container's compose project up"},
+	{Name: "compose down", Usage: "tear the compose project down", Destructive: true},
+}
+
+// paletteModel is the `:` command palette: a textinput plus a filtered list
+// of the commands above, with its own Update/View like the logs and stats
+// sub-models.
+type paletteModel struct {
+	input  textinput.Model
+	cursor int
+}
+
+func newPaletteModel() paletteModel {
+	input := textinput.New()
+	input.Placeholder = "restart, kill [signal], rename <name>, exec <cmd>, inspect, prune, compose up|down..."
+	input.CharLimit = 120
+	input.Focus()
+	return paletteModel{input: input}
+}
+
+func (m paletteModel) matches() []paletteCommand {
+	q := strings.ToLower(strings.TrimSpace(m.input.Value()))
+	if q == "" {
+		return paletteCommands
+	}
+	var out []paletteCommand
+	for _, c := range paletteCommands {
+		if strings.HasPrefix(strings.ToLower(c.Name), strings.Fields(q)[0]) {
+			out = append(out, c)
+		}
+	}
+	return out
+}
+
+// paletteSubmitMsg is emitted on enter; the top-level model interprets
+// command/arg since it owns the backend and the current selection.
+type paletteSubmitMsg struct {
+	command string
+	arg     string
+}
+
+type paletteClosedMsg struct{}
+
+func (m paletteModel) Update(msg tea.Msg) (paletteModel, tea.Cmd) {
+	if key, ok := msg.(tea.KeyMsg); ok {
+		switch key.String() {
+		case "esc":
+			return m, func() tea.Msg { return paletteClosedMsg{} }
+		case "up":
+			if m.cursor > 0 {
+				m.cursor--
+			}
+			return m, nil
+		case "down":
+			if m.cursor < len(m.matches())-1 {
+				m.cursor++
+			}
+			return m, nil
+		case "enter":
+			raw := strings.TrimSpace(m.input.Value())
+			if raw == "" {
+				if matches := m.matches(); len(matches) > 0 {
+					raw = matches[m.cursor].Name
+				}
+			}
+
+			command, arg := raw, ""
+			for _, c := range paletteCommands {
+				if raw == c.Name || strings.HasPrefix(raw, c.Name+" ") {
+					command = c.Name
+					arg = strings.TrimSpace(strings.TrimPrefix(raw, c.Name))
+					break
+				}
+			}
+			return m, func() tea.Msg { return paletteSubmitMsg{command: command, arg: arg} }
+		}
+	}
+
+	var cmd tea.Cmd
+	m.input, cmd = m.input.Update(msg)
+	m.cursor = 0
+	return m, cmd
+}
+
+func (m paletteModel) View() string {
+	var b strings.Builder
+
+	b.WriteString(paletteTitleStyle.Render("⌘ Command Palette"))
+	b.WriteString("\n\n: ")
+	b.WriteString(m.input.View())
+	b.WriteString("\n\n")
+
+	for i, c := range m.matches() {
+		line := fmt.Sprintf("%-14s %s", c.Name, c.Usage)
+		if i == m.cursor {
+			b.WriteString(paletteSelectedStyle.Render("▸ " + line))
+		} else {
+			b.WriteString(paletteItemStyle.Render("  " + line))
+		}
+		b.WriteString("\n")
+	}
+
+	b.WriteString("\n")
+	b.WriteString(helpStyle.Render("↑↓: select • enter: run • esc: cancel"))
+	return b.String()
+}
+
+// The functions below run a palette command through the active Backend,
+// following the same actionResult tea.Cmd pattern as startContainer et al.
+
+func restartContainer(b Backend, id string) tea.Cmd {
+	return func() tea.Msg {
+		if err := b.Restart(context.Background(), id); err != nil {
+			return actionResult{success: false, message: fmt.Sprintf("Failed to restart container: %v", err)}
+		}
+		return actionResult{success: true, message: fmt.Sprintf("Container %s restarted successfully", id[:12])}
+	}
+}
+
+func pauseContainer(b Backend, id string) tea.Cmd {
+	return func() tea.Msg {
+		if err := b.Pause(context.Background(), id); err != nil {
+			return actionResult{success: false, message: fmt.Sprintf("Failed to pause container: %v", err)}
+		}
+		return actionResult{success: true, message: fmt.Sprintf("Container %s paused successfully", id[:12])}
+	}
+}
+
+func unpauseContainer(b Backend, id string) tea.Cmd {
+	return func() tea.Msg {
+		if err := b.Unpause(context.Background(), id); err != nil {
+			return actionResult{success: false, message: fmt.Sprintf("Failed to unpause container: %v", err)}
+		}
+		return actionResult{success: true, message: fmt.Sprintf("Container %s unpaused successfully", id[:12])}
+	}
+}
+
+func killContainer(b Backend, id string, signal string) tea.Cmd {
+	if signal == "" {
+		signal = "KILL"
+	}
+	return func() tea.Msg {
+		if err := b.Kill(context.Background(), id, signal); err != nil {
+			return actionResult{success: false, message: fmt.Sprintf("Failed to kill container: %v", err)}
+		}
+		return actionResult{success: true, message: fmt.Sprintf("Container %s killed (%s) successfully", id[:12], signal)}
+	}
+}
+
+func renameContainer(b Backend, id string, newName string) tea.Cmd {
+	return func() tea.Msg {
+		if newName == "" {
+			return actionResult{success: false, message: "rename requires a new name: rename <new-name>"}
+		}
+		if err := b.Rename(context.Background(), id, newName); err != nil {
+			return actionResult{success: false, message: fmt.Sprintf("Failed to rename container: %v", err)}
+		}
+		return actionResult{success: true, message: fmt.Sprintf("Container %s renamed to %s", id[:12], newName)}
+	}
+}
+
+func pruneContainers(b Backend) tea.Cmd {
+	return func() tea.Msg {
+		summary, err := b.Prune(context.Background())
+		if err != nil {
+			return actionResult{success: false, message: fmt.Sprintf("Failed to prune containers: %v", err)}
+		}
+		return actionResult{success: true, message: summary}
+	}
+}
+
+// inspectResultMsg carries inspect output back to the controller, which
+// routes it into the logs viewport for scrolling rather than a new view.
+type inspectResultMsg struct {
+	name   string
+	output string
+	err    error
+}
+
+func inspectContainer(b Backend, id, name string) tea.Cmd {
+	return func() tea.Msg {
+		output, err := b.Inspect(context.Background(), id)
+		return inspectResultMsg{name: name, output: output, err: err}
+	}
+}
+
+// execIntoContainer suspends the TUI and attaches an interactive shell in
+// the container via `docker exec -it`, matching the approach bubbletea
+// recommends for handing the terminal off to another process. Both exec and
+// compose shell out to the `docker` binary directly rather than going
+// through Backend, since neither has a CRI equivalent; backends that aren't
+// "docker" report a clean unsupported error instead of invoking it.
+func execIntoContainer(b Backend, id, shellCmd string) tea.Cmd {
+	if b.Name() != "docker" {
+		return func() tea.Msg {
+			return actionResult{success: false, message: fmt.Sprintf("exec is not supported by the %s backend", b.Name())}
+		}
+	}
+	if shellCmd == "" {
+		shellCmd = "/bin/sh"
+	}
+	args := append([]string{"exec", "-it", id}, strings.Fields(shellCmd)...)
+	c := exec.Command("docker", args...)
+	return tea.ExecProcess(c, func(err error) tea.Msg {
+		if err != nil {
+			return actionResult{success: false, message: fmt.Sprintf("exec session failed: %v", err)}
+		}
+		return actionResult{success: true, message: "exec session ended"}
+	})
+}
+
+// composeProject runs `docker compose -p <project> <action>` for the
+// compose project the selected container belongs to. Compose operates at
+// the project level rather than the single-container level the Backend
+// interface models, so it shells out directly like exec does.
+func composeProject(b Backend, project, action string) tea.Cmd {
+	return func() tea.Msg {
+		if b.Name() != "docker" {
+			return actionResult{success: false, message: fmt.Sprintf("compose is not supported by the %s backend", b.Name())}
+		}
+		if project == "" {
+			return actionResult{success: false, message: "selected container has no com.docker.compose.project label"}
+		}
+		args := []string{"compose", "-p", project, action}
+		if action == "up" {
+			args = append(args, "-d")
+		}
+		out, err := exec.Command("docker", args...).CombinedOutput()
+		if err != nil {
+			return actionResult{success: false, message: fmt.Sprintf("compose %s failed: %v: %s", action, err, strings.TrimSpace(string(out)))}
+		}
+		return actionResult{success: true, message: fmt.Sprintf("compose %s (%s) completed", action, project)}
+	}
+}
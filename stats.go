@@ -0,0 +1,316 @@
+package main
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/lipgloss"
+)
+
+const statsTickInterval = time.Second
+const statsHistoryLen = 40
+const statsMaxRetries = 5
+
+var (
+	statsTitleStyle = lipgloss.NewStyle().
+			Foreground(lipgloss.Color("86")).
+			Bold(true).
+			Padding(0, 1)
+
+	statsLabelStyle = lipgloss.NewStyle().
+			Foreground(lipgloss.Color("241"))
+
+	statsBarStyle = lipgloss.NewStyle().
+			Foreground(lipgloss.Color("82"))
+
+	statsRetryStyle = lipgloss.NewStyle().
+			Foreground(lipgloss.Color("226")).
+			Bold(true)
+)
+
+// statsStreamStarted carries the channel a Backend.Stats call returned,
+// along with the cancel func used to tear the stream down on view exit.
+type statsStreamStarted struct {
+	cancel context.CancelFunc
+	ch     <-chan Stats
+}
+
+// statsSampleMsg wraps a single Stats sample read off the channel.
+type statsSampleMsg Stats
+
+// statsStreamClosed is sent when the channel closes, whether cleanly (view
+// exit) or because the backend dropped the connection.
+type statsStreamClosed struct{}
+
+// statsTickMsg drives the overview mode's periodic re-poll of every
+// container, independent of the single-container streaming view.
+type statsTickMsg time.Time
+
+func statsTick() tea.Cmd {
+	return tea.Tick(statsTickInterval, func(t time.Time) tea.Msg {
+		return statsTickMsg(t)
+	})
+}
+
+// waitForStatsSample blocks for the next sample, re-arming itself after
+// each message the same way waitForLogLine does for the logs pane.
+func waitForStatsSample(ch <-chan Stats) tea.Cmd {
+	return func() tea.Msg {
+		s, ok := <-ch
+		if !ok {
+			return statsStreamClosed{}
+		}
+		return statsSampleMsg(s)
+	}
+}
+
+// statsModel is the sub-model shown when `t` is pressed on a selected
+// container. It streams live CPU/mem/net/block-IO samples and renders
+// lipgloss sparklines, with a retry loop if the stream drops, and an
+// "overview" toggle that aggregates top-N containers by CPU/mem instead of
+// drilling into a single one.
+type statsModel struct {
+	backend       Backend
+	containerID   string
+	containerName string
+
+	cancel  context.CancelFunc
+	ch      <-chan Stats
+	retries int
+
+	latest     Stats
+	cpuHistory []float64
+	memHistory []float64
+
+	overview      bool
+	allContainers []Container
+	overviewRows  []Stats
+
+	width, height int
+}
+
+func newStatsModel(backend Backend, containerID, containerName string, width, height int) statsModel {
+	return statsModel{
+		backend:       backend,
+		containerID:   containerID,
+		containerName: containerName,
+		width:         width,
+		height:        height,
+	}
+}
+
+func startStatsStream(backend Backend, containerID string) tea.Cmd {
+	return func() tea.Msg {
+		ctx, cancel := context.WithCancel(context.Background())
+		ch, err := backend.Stats(ctx, containerID)
+		if err != nil {
+			cancel()
+			return statsStreamClosed{}
+		}
+		return statsStreamStarted{cancel: cancel, ch: ch}
+	}
+}
+
+// pollOverviewSample takes one Stats sample from each container so the
+// overview can rank them by CPU/mem without holding open N live streams.
+func pollOverviewSample(backend Backend, containers []Container) tea.Cmd {
+	return func() tea.Msg {
+		samples := make([]Stats, 0, len(containers))
+		for _, c := range containers {
+			if c.State != "running" {
+				continue
+			}
+			ctx, cancel := context.WithTimeout(context.Background(), 2*time.Second)
+			ch, err := backend.Stats(ctx, c.ID)
+			if err != nil {
+				cancel()
+				continue
+			}
+			if s, ok := <-ch; ok {
+				samples = append(samples, s)
+			}
+			cancel()
+		}
+		return overviewSampleMsg(samples)
+	}
+}
+
+type overviewSampleMsg []Stats
+
+func (m statsModel) pushSample(s Stats) statsModel {
+	m.latest = s
+	m.retries = 0
+	m.cpuHistory = append(m.cpuHistory, s.CPUPercent)
+	if len(m.cpuHistory) > statsHistoryLen {
+		m.cpuHistory = m.cpuHistory[len(m.cpuHistory)-statsHistoryLen:]
+	}
+	memPercent := 0.0
+	if s.MemLimit > 0 {
+		memPercent = float64(s.MemUsage) / float64(s.MemLimit) * 100
+	}
+	m.memHistory = append(m.memHistory, memPercent)
+	if len(m.memHistory) > statsHistoryLen {
+		m.memHistory = m.memHistory[len(m.memHistory)-statsHistoryLen:]
+	}
+	return m
+}
+
+func (m statsModel) Update(msg tea.Msg) (statsModel, tea.Cmd) {
+	switch msg := msg.(type) {
+	case statsStreamStarted:
+		m.cancel = msg.cancel
+		m.ch = msg.ch
+		return m, waitForStatsSample(m.ch)
+
+	case statsSampleMsg:
+		m = m.pushSample(Stats(msg))
+		if m.ch != nil {
+			return m, waitForStatsSample(m.ch)
+		}
+		return m, nil
+
+	case statsStreamClosed:
+		if m.overview {
+			return m, nil
+		}
+		if m.retries >= statsMaxRetries {
+			return m, nil
+		}
+		m.retries++
+		backoff := time.Duration(m.retries) * 500 * time.Millisecond
+		return m, tea.Tick(backoff, func(t time.Time) tea.Msg {
+			return reconnectStatsMsg{}
+		})
+
+	case reconnectStatsMsg:
+		return m, startStatsStream(m.backend, m.containerID)
+
+	case overviewSampleMsg:
+		sorted := append([]Stats(nil), msg...)
+		sort.Slice(sorted, func(i, j int) bool { return sorted[i].CPUPercent > sorted[j].CPUPercent })
+		m.overviewRows = sorted
+		return m, statsTick()
+
+	case statsTickMsg:
+		if m.overview {
+			return m, pollOverviewSample(m.backend, m.allContainers)
+		}
+		return m, nil
+
+	case tea.KeyMsg:
+		switch msg.String() {
+		case "a":
+			m.stop()
+			m.overview = !m.overview
+			if m.overview {
+				return m, pollOverviewSample(m.backend, m.allContainers)
+			}
+			return m, startStatsStream(m.backend, m.containerID)
+		}
+	}
+	return m, nil
+}
+
+// reconnectStatsMsg fires after a backoff delay to re-open a dropped
+// single-container stats stream.
+type reconnectStatsMsg struct{}
+
+func sparkline(history []float64, width int) string {
+	ramp := []rune(" ▁▂▃▄▅▆▇█")
+	if len(history) == 0 {
+		return strings.Repeat(" ", width)
+	}
+
+	var b strings.Builder
+	start := 0
+	if len(history) > width {
+		start = len(history) - width
+	}
+	for _, v := range history[start:] {
+		idx := int(v / 100 * float64(len(ramp)-1))
+		if idx < 0 {
+			idx = 0
+		}
+		if idx >= len(ramp) {
+			idx = len(ramp) - 1
+		}
+		b.WriteRune(ramp[idx])
+	}
+	return statsBarStyle.Render(b.String())
+}
+
+func (m statsModel) View() string {
+	var b strings.Builder
+
+	if m.overview {
+		b.WriteString(statsTitleStyle.Render("📊 Stats Overview (top by CPU)"))
+		b.WriteString("\n\n")
+		for i, s := range m.overviewRows {
+			if i >= 10 {
+				break
+			}
+			name := statsContainerName(m.allContainers, s.ContainerID)
+			b.WriteString(fmt.Sprintf("%-25s %6.1f%% CPU   %s\n", truncate(name, 25), s.CPUPercent, formatBytes(s.MemUsage)))
+		}
+		b.WriteString("\n")
+		b.WriteString(helpStyle.Render("a: back to single-container view • esc: back"))
+		return b.String()
+	}
+
+	b.WriteString(statsTitleStyle.Render(fmt.Sprintf("📊 Stats: %s", m.containerName)))
+	if m.retries > 0 {
+		b.WriteString(" " + statsRetryStyle.Render(fmt.Sprintf("[reconnecting %d/%d]", m.retries, statsMaxRetries)))
+	}
+	b.WriteString("\n\n")
+
+	memPercent := 0.0
+	if m.latest.MemLimit > 0 {
+		memPercent = float64(m.latest.MemUsage) / float64(m.latest.MemLimit) * 100
+	}
+
+	b.WriteString(statsLabelStyle.Render(fmt.Sprintf("CPU  %5.1f%% ", m.latest.CPUPercent)))
+	b.WriteString(sparkline(m.cpuHistory, 40))
+	b.WriteString("\n")
+	b.WriteString(statsLabelStyle.Render(fmt.Sprintf("MEM  %5.1f%% ", memPercent)))
+	b.WriteString(sparkline(m.memHistory, 40))
+	b.WriteString("\n\n")
+
+	b.WriteString(statsLabelStyle.Render(fmt.Sprintf("NET  rx %s / tx %s\n", formatBytes(m.latest.NetRx), formatBytes(m.latest.NetTx))))
+	b.WriteString(statsLabelStyle.Render(fmt.Sprintf("BLK  read %s / write %s\n", formatBytes(m.latest.BlockRead), formatBytes(m.latest.BlockWrite))))
+
+	b.WriteString("\n")
+	b.WriteString(helpStyle.Render("a: overview mode • esc: back"))
+	return b.String()
+}
+
+func (m statsModel) stop() {
+	if m.cancel != nil {
+		m.cancel()
+	}
+}
+
+func statsContainerName(containers []Container, id string) string {
+	for _, c := range containers {
+		if c.ID == id {
+			return strings.TrimPrefix(c.Names, "/")
+		}
+	}
+	return truncate(id, 12)
+}
+
+func formatBytes(b uint64) string {
+	const unit = 1024
+	if b < unit {
+		return fmt.Sprintf("%dB", b)
+	}
+	div, exp := uint64(unit), 0
+	for n := b / unit; n >= unit; n /= unit {
+		div *= unit
+		exp++
+	}
+	return fmt.Sprintf("%.1f%ciB", float64(b)/float64(div), "KMGTPE"[exp])
+}